@@ -0,0 +1,131 @@
+package blobdecoder
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// versionedHashVersion is the single byte EIP-4844 versioned hashes are
+// prefixed with today (KZG over BLS12-381).
+const versionedHashVersion = 0x01
+
+// DefaultBeaconTimeout bounds how long NewBeaconClient's default client
+// will wait for a beacon node response before giving up.
+const DefaultBeaconTimeout = 30 * time.Second
+
+// BeaconClient fetches blob sidecars from an L1 beacon node's REST API, so
+// operators can reproduce any Taiko batch from mainnet or holesky on
+// demand instead of keeping blob fixtures around locally.
+type BeaconClient struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewBeaconClient returns a client for the beacon node at baseURL, e.g.
+// "http://localhost:5052". timeout bounds every request issued by the
+// client; a non-positive timeout falls back to DefaultBeaconTimeout, since
+// a diagnostic tool pointed at a live mainnet/holesky node should never
+// hang indefinitely on an unresponsive endpoint.
+func NewBeaconClient(baseURL string, timeout time.Duration) *BeaconClient {
+	if timeout <= 0 {
+		timeout = DefaultBeaconTimeout
+	}
+	return &BeaconClient{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+// BlobSidecar is the subset of a beacon /eth/v1/beacon/blob_sidecars/{id}
+// response entry that blobdecoder needs.
+type BlobSidecar struct {
+	Index         uint64
+	Blob          []byte
+	KZGCommitment []byte
+}
+
+type sidecarResponse struct {
+	Data []struct {
+		Index         string `json:"index"`
+		Blob          string `json:"blob"`
+		KZGCommitment string `json:"kzg_commitment"`
+	} `json:"data"`
+}
+
+// FetchBlobSidecars queries the beacon node's blob_sidecars endpoint for
+// the given slot and returns every sidecar in the response.
+func (c *BeaconClient) FetchBlobSidecars(ctx context.Context, slot uint64) ([]BlobSidecar, error) {
+	url := fmt.Sprintf("%s/eth/v1/beacon/blob_sidecars/%d", c.baseURL, slot)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("blobdecoder: building beacon request: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("blobdecoder: querying beacon node at %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("blobdecoder: beacon node returned %s for slot %d", resp.Status, slot)
+	}
+
+	var parsed sidecarResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("blobdecoder: decoding beacon response: %w", err)
+	}
+
+	sidecars := make([]BlobSidecar, 0, len(parsed.Data))
+	for _, d := range parsed.Data {
+		blob, err := hex.DecodeString(strings.TrimPrefix(d.Blob, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("blobdecoder: decoding blob hex for index %s: %w", d.Index, err)
+		}
+		commitment, err := hex.DecodeString(strings.TrimPrefix(d.KZGCommitment, "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("blobdecoder: decoding kzg_commitment hex for index %s: %w", d.Index, err)
+		}
+
+		var index uint64
+		if _, err := fmt.Sscanf(d.Index, "%d", &index); err != nil {
+			return nil, fmt.Errorf("blobdecoder: parsing sidecar index %q: %w", d.Index, err)
+		}
+
+		sidecars = append(sidecars, BlobSidecar{Index: index, Blob: blob, KZGCommitment: commitment})
+	}
+	return sidecars, nil
+}
+
+// VersionedHash computes the EIP-4844 versioned hash of a KZG commitment:
+// the version byte followed by the low 31 bytes of its SHA-256 digest.
+func VersionedHash(commitment []byte) [32]byte {
+	digest := sha256.Sum256(commitment)
+	digest[0] = versionedHashVersion
+	return digest
+}
+
+// FetchBlobByVersionedHash fetches the sidecars for slot and returns the
+// raw (still padded) blob bytes of the sidecar whose KZG commitment
+// hashes to expected. The blob bytes are not checked against the
+// commitment (no KZG proof verification is done).
+func (c *BeaconClient) FetchBlobByVersionedHash(ctx context.Context, slot uint64, expected [32]byte) ([]byte, error) {
+	sidecars, err := c.FetchBlobSidecars(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sc := range sidecars {
+		if VersionedHash(sc.KZGCommitment) == expected {
+			return sc.Blob, nil
+		}
+	}
+	return nil, fmt.Errorf("blobdecoder: no sidecar in slot %d matches versioned hash 0x%x", slot, expected)
+}
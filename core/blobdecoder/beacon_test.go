@@ -0,0 +1,128 @@
+package blobdecoder
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestVersionedHash(t *testing.T) {
+	commitment := []byte("a fake KZG commitment, 48 bytes in the real thing")
+
+	got := VersionedHash(commitment)
+	want := sha256.Sum256(commitment)
+	want[0] = versionedHashVersion
+
+	if got != want {
+		t.Fatalf("VersionedHash(%x) = %x, want %x", commitment, got, want)
+	}
+	if got[0] != versionedHashVersion {
+		t.Fatalf("VersionedHash did not set the version byte: got %#x, want %#x", got[0], versionedHashVersion)
+	}
+}
+
+// sidecarServer returns an httptest.Server that serves a single
+// blob_sidecars response for the given slot, with one sidecar per
+// (blob, commitment) pair.
+func sidecarServer(t *testing.T, slot uint64, blobs, commitments [][]byte) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(fmt.Sprintf("/eth/v1/beacon/blob_sidecars/%d", slot), func(w http.ResponseWriter, r *http.Request) {
+		var body strings.Builder
+		body.WriteString(`{"data":[`)
+		for i := range blobs {
+			if i > 0 {
+				body.WriteString(",")
+			}
+			fmt.Fprintf(&body, `{"index":"%d","blob":"0x%s","kzg_commitment":"0x%s"}`,
+				i, hex.EncodeToString(blobs[i]), hex.EncodeToString(commitments[i]))
+		}
+		body.WriteString(`]}`)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body.String()))
+	})
+
+	return httptest.NewServer(mux)
+}
+
+func TestFetchBlobSidecars(t *testing.T) {
+	blob := []byte("decompressed-later compressed blob bytes")
+	commitment := []byte("a fake 48-byte KZG commitment.............")
+
+	srv := sidecarServer(t, 42, [][]byte{blob}, [][]byte{commitment})
+	defer srv.Close()
+
+	client := NewBeaconClient(srv.URL, 0)
+	sidecars, err := client.FetchBlobSidecars(context.Background(), 42)
+	if err != nil {
+		t.Fatalf("FetchBlobSidecars: %v", err)
+	}
+	if len(sidecars) != 1 {
+		t.Fatalf("got %d sidecars, want 1", len(sidecars))
+	}
+	if sidecars[0].Index != 0 {
+		t.Errorf("Index = %d, want 0", sidecars[0].Index)
+	}
+	if string(sidecars[0].Blob) != string(blob) {
+		t.Errorf("Blob = %q, want %q", sidecars[0].Blob, blob)
+	}
+	if string(sidecars[0].KZGCommitment) != string(commitment) {
+		t.Errorf("KZGCommitment = %q, want %q", sidecars[0].KZGCommitment, commitment)
+	}
+}
+
+func TestFetchBlobSidecarsHTTPError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "not found", http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	client := NewBeaconClient(srv.URL, 0)
+	if _, err := client.FetchBlobSidecars(context.Background(), 42); err == nil {
+		t.Fatal("expected an error for a non-200 beacon response, got nil")
+	}
+}
+
+func TestFetchBlobByVersionedHash(t *testing.T) {
+	blobA := []byte("blob A bytes")
+	blobB := []byte("blob B bytes")
+	commitmentA := []byte("commitment A, 48 bytes in the real thing...")
+	commitmentB := []byte("commitment B, 48 bytes in the real thing...")
+
+	srv := sidecarServer(t, 7, [][]byte{blobA, blobB}, [][]byte{commitmentA, commitmentB})
+	defer srv.Close()
+
+	client := NewBeaconClient(srv.URL, 0)
+
+	got, err := client.FetchBlobByVersionedHash(context.Background(), 7, VersionedHash(commitmentB))
+	if err != nil {
+		t.Fatalf("FetchBlobByVersionedHash: %v", err)
+	}
+	if string(got) != string(blobB) {
+		t.Fatalf("got blob %q, want %q", got, blobB)
+	}
+}
+
+func TestFetchBlobByVersionedHashMismatch(t *testing.T) {
+	blob := []byte("blob bytes")
+	commitment := []byte("commitment, 48 bytes in the real thing.....")
+
+	srv := sidecarServer(t, 7, [][]byte{blob}, [][]byte{commitment})
+	defer srv.Close()
+
+	client := NewBeaconClient(srv.URL, 0)
+
+	var wrongHash [32]byte
+	copy(wrongHash[:], []byte("not the hash of any sidecar here"))
+
+	if _, err := client.FetchBlobByVersionedHash(context.Background(), 7, wrongHash); err == nil {
+		t.Fatal("expected an error when no sidecar matches the versioned hash, got nil")
+	}
+}
@@ -0,0 +1,75 @@
+package blobdecoder
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+const fieldElementSize = 32
+
+// StripFieldElementPadding undoes the EIP-4844 blob encoding: a blob is a
+// sequence of 32-byte BLS12-381 field elements, and since a field element
+// must be less than the BLS12-381 modulus, every element is left-padded
+// with a single zero byte. This returns the concatenation of the 31
+// meaningful bytes of each element, in order.
+func StripFieldElementPadding(blob []byte) ([]byte, error) {
+	if len(blob)%fieldElementSize != 0 {
+		return nil, fmt.Errorf("blobdecoder: blob length %d is not a multiple of the field element size %d", len(blob), fieldElementSize)
+	}
+
+	out := make([]byte, 0, len(blob)/fieldElementSize*(fieldElementSize-1))
+	for i := 0; i < len(blob); i += fieldElementSize {
+		element := blob[i : i+fieldElementSize]
+		if element[0] != 0 {
+			return nil, fmt.Errorf("blobdecoder: field element %d has non-zero top byte 0x%02x, not a valid 4844 blob", i/fieldElementSize, element[0])
+		}
+		out = append(out, element[1:]...)
+	}
+	return out, nil
+}
+
+// Decode reads a raw EIP-4844 blob (or, if raw is false, an already
+// de-padded compressed payload) from r, decompresses it, and parses the
+// result into a list of Taiko L2 transaction summaries. If forced is
+// CodecUnknown the codec is auto-detected from the payload's magic bytes;
+// otherwise forced is used as-is, which is useful when auto-detection is
+// ambiguous or a non-standard blob is being debugged.
+func Decode(r io.Reader, raw bool, forced Codec) ([]TxSummary, error) {
+	payload, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("blobdecoder: reading input: %w", err)
+	}
+
+	if raw {
+		payload, err = StripFieldElementPadding(payload)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	codec := forced
+	if codec == CodecUnknown {
+		peekLen := 4
+		if len(payload) < peekLen {
+			peekLen = len(payload)
+		}
+		codec, err = DetectCodec(payload[:peekLen])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	decompressor, err := NewReader(codec, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("blobdecoder: creating %s decompressor: %w", codec, err)
+	}
+	defer decompressor.Close()
+
+	decompressed, err := io.ReadAll(decompressor)
+	if err != nil {
+		return nil, fmt.Errorf("blobdecoder: decompressing %s payload: %w", codec, err)
+	}
+
+	return DecodeTransactions(decompressed)
+}
@@ -0,0 +1,24 @@
+package blobdecoder
+
+import (
+	"compress/bzip2"
+	"fmt"
+	"io"
+)
+
+type bzip2Codec struct{}
+
+func init() {
+	registerCodec(CodecBzip2, bzip2Codec{})
+}
+
+func (bzip2Codec) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return io.NopCloser(bzip2.NewReader(r)), nil
+}
+
+// NewWriter always fails: the standard library only ships a bzip2 reader.
+// No Taiko proposer writes bzip2 blobs; the decoder exists for robustness
+// against blobs produced by non-standard tooling.
+func (bzip2Codec) NewWriter(w io.Writer, level int) (io.WriteCloser, error) {
+	return nil, fmt.Errorf("blobdecoder: bzip2 compression is not supported, only decompression")
+}
@@ -0,0 +1,131 @@
+// Package blobdecoder decodes Taiko L2 batch blobs: it strips EIP-4844
+// field-element padding, auto-detects the compression codec used by the
+// proposer, and decodes the resulting payload into an RLP list of
+// transactions.
+package blobdecoder
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+// Codec identifies a supported blob compression format.
+type Codec int
+
+const (
+	CodecUnknown Codec = iota
+	CodecZlib
+	CodecGzip
+	CodecZstd
+	CodecBzip2
+	CodecLZ4
+)
+
+func (c Codec) String() string {
+	switch c {
+	case CodecZlib:
+		return "zlib"
+	case CodecGzip:
+		return "gzip"
+	case CodecZstd:
+		return "zstd"
+	case CodecBzip2:
+		return "bzip2"
+	case CodecLZ4:
+		return "lz4"
+	default:
+		return "unknown"
+	}
+}
+
+// CodecImpl is a pluggable compressor/decompressor backend. Implementations
+// register themselves in registry via registerCodec from an init function,
+// following the same registration pattern as image or hash format drivers
+// in the standard library.
+type CodecImpl interface {
+	NewReader(r io.Reader) (io.ReadCloser, error)
+	NewWriter(w io.Writer, level int) (io.WriteCloser, error)
+}
+
+var registry = map[Codec]CodecImpl{}
+
+// registerCodec is called from each backend's init function.
+func registerCodec(codec Codec, impl CodecImpl) {
+	registry[codec] = impl
+}
+
+var (
+	magicGzip  = []byte{0x1f, 0x8b}
+	magicZstd  = []byte{0x28, 0xb5, 0x2f, 0xfd}
+	magicBzip2 = []byte("BZh")
+	magicLZ4   = []byte{0x04, 0x22, 0x4d, 0x18}
+)
+
+// DetectCodec peeks at the leading bytes of a compressed payload whose codec
+// is not yet known and returns the codec whose magic bytes match. zlib streams are
+// recognized by their two-byte CMF/FLG header (0x78 followed by one of the
+// standard FLG values), per RFC 1950.
+func DetectCodec(peek []byte) (Codec, error) {
+	switch {
+	case len(peek) >= 2 && bytes.Equal(peek[:2], magicGzip):
+		return CodecGzip, nil
+	case len(peek) >= 4 && bytes.Equal(peek[:4], magicZstd):
+		return CodecZstd, nil
+	case len(peek) >= 4 && bytes.Equal(peek[:4], magicLZ4):
+		return CodecLZ4, nil
+	case len(peek) >= 3 && bytes.Equal(peek[:3], magicBzip2):
+		return CodecBzip2, nil
+	case len(peek) >= 2 && peek[0] == 0x78 && isValidZlibFlg(peek[0], peek[1]):
+		return CodecZlib, nil
+	default:
+		return CodecUnknown, fmt.Errorf("blobdecoder: unrecognized compression magic bytes %x", peek)
+	}
+}
+
+// isValidZlibFlg checks the RFC 1950 constraint that CMF*256+FLG is a
+// multiple of 31, which is how zlib readers validate their own header.
+func isValidZlibFlg(cmf, flg byte) bool {
+	return (int(cmf)*256+int(flg))%31 == 0
+}
+
+// ParseCodec maps a CLI-facing codec name to its Codec value, for the
+// -codec flag that overrides auto-detection.
+func ParseCodec(name string) (Codec, error) {
+	switch name {
+	case "", "auto":
+		return CodecUnknown, nil
+	case "zlib":
+		return CodecZlib, nil
+	case "gzip":
+		return CodecGzip, nil
+	case "zstd":
+		return CodecZstd, nil
+	case "bzip2":
+		return CodecBzip2, nil
+	case "lz4":
+		return CodecLZ4, nil
+	default:
+		return CodecUnknown, fmt.Errorf("blobdecoder: unknown codec %q", name)
+	}
+}
+
+// NewReader dispatches to the decompressor backend registered for codec.
+func NewReader(codec Codec, r io.Reader) (io.ReadCloser, error) {
+	impl, ok := registry[codec]
+	if !ok {
+		return nil, fmt.Errorf("blobdecoder: no decoder registered for codec %s", codec)
+	}
+	return impl.NewReader(r)
+}
+
+// NewWriter dispatches to the compressor backend registered for codec, at
+// the given compression level (codec-specific meaning; pass -1 for the
+// backend's default).
+func NewWriter(codec Codec, w io.Writer, level int) (io.WriteCloser, error) {
+	impl, ok := registry[codec]
+	if !ok {
+		return nil, fmt.Errorf("blobdecoder: no encoder registered for codec %s", codec)
+	}
+	return impl.NewWriter(w, level)
+}
@@ -0,0 +1,121 @@
+package blobdecoder
+
+import (
+	"bytes"
+	"io"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// sampleBlobPayload builds a deterministic RLP-encoded transaction list
+// that is representative of what a decompressed Taiko batch blob looks
+// like. This tree has no real captured mainnet/holesky blob checked in as
+// a fixture, so the round-trip and ratio comparisons below exercise the
+// codecs against this synthetic-but-realistic stand-in instead.
+func sampleBlobPayload(t *testing.T) []byte {
+	t.Helper()
+
+	txs := make([]*types.Transaction, 256)
+	for i := range txs {
+		txs[i] = types.NewTransaction(
+			uint64(i),
+			common.BigToAddress(big.NewInt(int64(i))),
+			big.NewInt(int64(i)*1_000_000_000),
+			21000,
+			big.NewInt(1_000_000_000),
+			nil,
+		)
+	}
+
+	data, err := rlp.EncodeToBytes(txs)
+	if err != nil {
+		t.Fatalf("encoding sample transaction list: %v", err)
+	}
+	return data
+}
+
+func TestCodecRoundTrip(t *testing.T) {
+	payload := sampleBlobPayload(t)
+
+	for _, codec := range []Codec{CodecZlib, CodecGzip, CodecZstd, CodecLZ4} {
+		t.Run(codec.String(), func(t *testing.T) {
+			var buf bytes.Buffer
+			w, err := NewWriter(codec, &buf, -1)
+			if err != nil {
+				t.Fatalf("NewWriter(%s): %v", codec, err)
+			}
+			if _, err := w.Write(payload); err != nil {
+				t.Fatalf("writing %s payload: %v", codec, err)
+			}
+			if err := w.Close(); err != nil {
+				t.Fatalf("closing %s writer: %v", codec, err)
+			}
+
+			detected, err := DetectCodec(buf.Bytes()[:4])
+			if err != nil {
+				t.Fatalf("DetectCodec on %s output: %v", codec, err)
+			}
+			if detected != codec {
+				t.Fatalf("DetectCodec misidentified %s output as %s", codec, detected)
+			}
+
+			r, err := NewReader(codec, bytes.NewReader(buf.Bytes()))
+			if err != nil {
+				t.Fatalf("NewReader(%s): %v", codec, err)
+			}
+			defer r.Close()
+
+			got, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatalf("reading back %s payload: %v", codec, err)
+			}
+			if !bytes.Equal(got, payload) {
+				t.Fatalf("%s round-trip mismatch: got %d bytes, want %d bytes", codec, len(got), len(payload))
+			}
+		})
+	}
+}
+
+func TestBzip2DecodeOnly(t *testing.T) {
+	if _, err := NewWriter(CodecBzip2, &bytes.Buffer{}, -1); err == nil {
+		t.Fatal("expected bzip2 NewWriter to report unsupported compression")
+	}
+}
+
+// TestCompressionRatios compares the compressed size each codec produces
+// for the same payload, which is the evaluation this backlog request was
+// meant to unblock: deciding whether to move Taiko's L1 blob format off
+// zlib. It does not assert a winner since ratios are data-dependent, only
+// that every codec actually shrinks this payload; lossless round-trips are
+// checked by TestCodecRoundTrip.
+func TestCompressionRatios(t *testing.T) {
+	payload := sampleBlobPayload(t)
+
+	sizes := make(map[Codec]int)
+	for _, codec := range []Codec{CodecZlib, CodecGzip, CodecZstd, CodecLZ4} {
+		var buf bytes.Buffer
+		w, err := NewWriter(codec, &buf, -1)
+		if err != nil {
+			t.Fatalf("NewWriter(%s): %v", codec, err)
+		}
+		if _, err := w.Write(payload); err != nil {
+			t.Fatalf("writing %s payload: %v", codec, err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("closing %s writer: %v", codec, err)
+		}
+		sizes[codec] = buf.Len()
+
+		if buf.Len() >= len(payload) {
+			t.Errorf("%s did not shrink the payload: %d compressed vs %d raw bytes", codec, buf.Len(), len(payload))
+		}
+	}
+
+	for codec, size := range sizes {
+		t.Logf("%-6s %6d bytes (%.1f%% of raw %d bytes)", codec, size, 100*float64(size)/float64(len(payload)), len(payload))
+	}
+}
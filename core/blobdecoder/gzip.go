@@ -0,0 +1,23 @@
+package blobdecoder
+
+import (
+	"compress/gzip"
+	"io"
+)
+
+type gzipCodec struct{}
+
+func init() {
+	registerCodec(CodecGzip, gzipCodec{})
+}
+
+func (gzipCodec) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return gzip.NewReader(r)
+}
+
+func (gzipCodec) NewWriter(w io.Writer, level int) (io.WriteCloser, error) {
+	if level == -1 {
+		level = gzip.DefaultCompression
+	}
+	return gzip.NewWriterLevel(w, level)
+}
@@ -0,0 +1,27 @@
+package blobdecoder
+
+import (
+	"io"
+
+	"github.com/pierrec/lz4/v4"
+)
+
+type lz4Codec struct{}
+
+func init() {
+	registerCodec(CodecLZ4, lz4Codec{})
+}
+
+func (lz4Codec) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return io.NopCloser(lz4.NewReader(r)), nil
+}
+
+func (lz4Codec) NewWriter(w io.Writer, level int) (io.WriteCloser, error) {
+	zw := lz4.NewWriter(w)
+	if level != -1 {
+		if err := zw.Apply(lz4.CompressionLevelOption(lz4.CompressionLevel(level))); err != nil {
+			return nil, err
+		}
+	}
+	return zw, nil
+}
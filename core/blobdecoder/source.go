@@ -0,0 +1,34 @@
+package blobdecoder
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+)
+
+// FromStdin reads raw blob (or compressed payload) bytes from standard
+// input.
+func FromStdin() ([]byte, error) {
+	data, err := io.ReadAll(os.Stdin)
+	if err != nil {
+		return nil, fmt.Errorf("blobdecoder: reading stdin: %w", err)
+	}
+	return data, nil
+}
+
+// FromHexFile reads a hex-encoded blob (or compressed payload) from a file
+// on disk.
+func FromHexFile(path string) ([]byte, error) {
+	hexData, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("blobdecoder: reading %s: %w", path, err)
+	}
+
+	decoded := make([]byte, hex.DecodedLen(len(hexData)))
+	n, err := hex.Decode(decoded, hexData)
+	if err != nil {
+		return nil, fmt.Errorf("blobdecoder: hex-decoding %s: %w", path, err)
+	}
+	return decoded[:n], nil
+}
@@ -0,0 +1,208 @@
+package blobdecoder
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// StreamDecoder decodes a Taiko L2 batch blob incrementally: it never
+// buffers the full decompressed payload, instead reading RLP list elements
+// off the decompressor as they become available. This lets a guest prover
+// start hashing and validating the first transactions in a blob while the
+// tail is still inflating.
+type StreamDecoder struct {
+	raw    bool
+	forced Codec
+
+	br           *bufio.Reader
+	decompressor io.ReadCloser
+	stream       *rlp.Stream
+
+	// cancel and done track an in-flight Transactions goroutine: cancel is
+	// closed to ask it to stop early (e.g. a caller that only wants the
+	// first few transactions and never drains the channel to EOF), and
+	// done is closed by the goroutine once it has actually exited. Reset
+	// and Close both wait on done before touching stream/decompressor, so
+	// a StreamDecoder can be safely reused even if the previous
+	// Transactions call was never drained to completion.
+	cancel chan struct{}
+	done   chan struct{}
+}
+
+// NewStreamDecoder creates a decoder for blobs that are raw EIP-4844 (still
+// field-element padded) if raw is true, using forced as the compression
+// codec or auto-detecting it if forced is CodecUnknown.
+func NewStreamDecoder(raw bool, forced Codec) *StreamDecoder {
+	return &StreamDecoder{raw: raw, forced: forced}
+}
+
+// Reset discards any in-progress decode and points the decoder at r, so a
+// single StreamDecoder can be reused across many blobs. Each call still
+// creates a new buffered reader and a new decompressor for r.
+func (d *StreamDecoder) Reset(r io.Reader) error {
+	d.stopInFlight()
+
+	if d.decompressor != nil {
+		d.decompressor.Close()
+		d.decompressor = nil
+	}
+
+	var src io.Reader = r
+	if d.raw {
+		src = &paddingStripReader{r: r}
+	}
+	d.br = bufio.NewReaderSize(src, 32*1024)
+
+	codec := d.forced
+	if codec == CodecUnknown {
+		peek, err := d.br.Peek(4)
+		if err != nil && err != io.EOF {
+			return fmt.Errorf("blobdecoder: peeking codec magic bytes: %w", err)
+		}
+		codec, err = DetectCodec(peek)
+		if err != nil {
+			return err
+		}
+	}
+
+	dec, err := NewReader(codec, d.br)
+	if err != nil {
+		return fmt.Errorf("blobdecoder: creating %s decompressor: %w", codec, err)
+	}
+	d.decompressor = dec
+	d.stream = rlp.NewStream(dec, 0)
+	return nil
+}
+
+// Close releases the underlying decompressor, first waiting for any
+// in-flight Transactions goroutine to exit.
+func (d *StreamDecoder) Close() error {
+	d.stopInFlight()
+
+	if d.decompressor == nil {
+		return nil
+	}
+	err := d.decompressor.Close()
+	d.decompressor = nil
+	return err
+}
+
+// stopInFlight asks a Transactions goroutine left over from a previous
+// call to stop, then blocks until it has actually exited. It is a no-op if
+// no such goroutine is running.
+func (d *StreamDecoder) stopInFlight() {
+	if d.done == nil {
+		return
+	}
+	select {
+	case <-d.cancel:
+	default:
+		close(d.cancel)
+	}
+	<-d.done
+	d.cancel, d.done = nil, nil
+}
+
+// Transactions streams the decoded transaction list on the returned
+// channel, one transaction at a time, as they are decoded off the
+// decompressor. The channel has capacity buf, which bounds how far
+// decoding can run ahead of the consumer: once it fills, decoding blocks
+// until the consumer drains it. The channel is closed when the list is
+// exhausted; a decode error, if any, is sent on the returned error channel
+// before that happens. If the caller stops draining the channel before it
+// is exhausted (e.g. it only wanted the first few transactions), the
+// goroutine is left blocked on the send until the next Reset or Close,
+// which cancel it and wait for it to exit before reusing the decoder. Call
+// Reset before calling Transactions again.
+func (d *StreamDecoder) Transactions(buf int) (<-chan *types.Transaction, <-chan error) {
+	out := make(chan *types.Transaction, buf)
+	errc := make(chan error, 1)
+
+	cancel := make(chan struct{})
+	done := make(chan struct{})
+	d.cancel, d.done = cancel, done
+
+	go func() {
+		defer close(done)
+		defer close(out)
+		defer close(errc)
+
+		if _, err := d.stream.List(); err != nil {
+			errc <- fmt.Errorf("blobdecoder: reading transaction list header: %w", err)
+			return
+		}
+
+		for {
+			select {
+			case <-cancel:
+				return
+			default:
+			}
+
+			var tx types.Transaction
+			if err := d.stream.Decode(&tx); err != nil {
+				if err == rlp.EOL {
+					break
+				}
+				errc <- fmt.Errorf("blobdecoder: decoding transaction: %w", err)
+				return
+			}
+
+			select {
+			case out <- &tx:
+			case <-cancel:
+				return
+			}
+		}
+
+		if err := d.stream.ListEnd(); err != nil {
+			errc <- fmt.Errorf("blobdecoder: closing transaction list: %w", err)
+		}
+	}()
+
+	return out, errc
+}
+
+// paddingStripReader strips the EIP-4844 field-element padding (the
+// zeroed top byte of every 32-byte element) from the underlying reader on
+// the fly, one element at a time, so a blob never has to be held in memory
+// in full just to remove its padding.
+type paddingStripReader struct {
+	r       io.Reader
+	chunk   [fieldElementSize]byte
+	element []byte
+	off     int
+}
+
+func (p *paddingStripReader) Read(buf []byte) (int, error) {
+	n := 0
+	for n < len(buf) {
+		if p.off >= len(p.element) {
+			if _, err := io.ReadFull(p.r, p.chunk[:]); err != nil {
+				if err == io.EOF {
+					if n > 0 {
+						return n, nil
+					}
+					return 0, io.EOF
+				}
+				if err == io.ErrUnexpectedEOF {
+					return n, fmt.Errorf("blobdecoder: blob ends mid field-element, not a multiple of %d bytes", fieldElementSize)
+				}
+				return n, err
+			}
+			if p.chunk[0] != 0 {
+				return n, fmt.Errorf("blobdecoder: field element has non-zero top byte 0x%02x, not a valid 4844 blob", p.chunk[0])
+			}
+			p.element = p.chunk[1:]
+			p.off = 0
+		}
+		c := copy(buf[n:], p.element[p.off:])
+		p.off += c
+		n += c
+	}
+	return n, nil
+}
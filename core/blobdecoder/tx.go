@@ -0,0 +1,46 @@
+package blobdecoder
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// TxSummary is a human-readable summary of a single decoded transaction,
+// printed by the CLI so operators can eyeball a blob's contents without
+// reaching for a full block explorer.
+type TxSummary struct {
+	Index    int
+	Hash     string
+	To       string
+	Nonce    uint64
+	Value    string
+	GasLimit uint64
+}
+
+// DecodeTransactions parses a decompressed Taiko L2 batch payload, an
+// RLP-encoded list of transactions, into summaries.
+func DecodeTransactions(data []byte) ([]TxSummary, error) {
+	var txs []*types.Transaction
+	if err := rlp.DecodeBytes(data, &txs); err != nil {
+		return nil, fmt.Errorf("blobdecoder: decoding RLP transaction list: %w", err)
+	}
+
+	summaries := make([]TxSummary, len(txs))
+	for i, tx := range txs {
+		to := "contract creation"
+		if tx.To() != nil {
+			to = tx.To().Hex()
+		}
+		summaries[i] = TxSummary{
+			Index:    i,
+			Hash:     tx.Hash().Hex(),
+			To:       to,
+			Nonce:    tx.Nonce(),
+			Value:    tx.Value().String(),
+			GasLimit: tx.Gas(),
+		}
+	}
+	return summaries, nil
+}
@@ -0,0 +1,23 @@
+package blobdecoder
+
+import (
+	"compress/zlib"
+	"io"
+)
+
+type zlibCodec struct{}
+
+func init() {
+	registerCodec(CodecZlib, zlibCodec{})
+}
+
+func (zlibCodec) NewReader(r io.Reader) (io.ReadCloser, error) {
+	return zlib.NewReader(r)
+}
+
+func (zlibCodec) NewWriter(w io.Writer, level int) (io.WriteCloser, error) {
+	if level == -1 {
+		level = zlib.DefaultCompression
+	}
+	return zlib.NewWriterLevel(w, level)
+}
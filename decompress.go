@@ -2,58 +2,125 @@ package main
 
 import (
 	"bytes"
-	"compress/zlib"
+	"context"
 	"encoding/hex"
+	"flag"
 	"fmt"
-	"io"
 	"os"
+	"time"
+
+	"github.com/taikoxyz/raiko/core/blobdecoder"
 )
 
-func readHexFile(filename string) ([]byte, error) {
-    hexData, err := os.ReadFile(filename)
-    if err != nil {
-        return nil, fmt.Errorf("文件读取错误: %w", err)
-    }
-
-    decoded := make([]byte, hex.DecodedLen(len(hexData)))
-    n, err := hex.Decode(decoded, hexData)
-    if err != nil {
-        return nil, fmt.Errorf("HEX解码失败: %w", err)
-    }
-    return decoded[:n], nil
+func main() {
+	var (
+		file          = flag.String("file", "", "path to a hex-encoded blob or compressed payload (defaults to stdin)")
+		raw           = flag.Bool("raw", true, "treat the input as a raw EIP-4844 blob that still has field-element padding")
+		codec         = flag.String("codec", "auto", "compression codec to use: auto, zlib, gzip, zstd, bzip2, or lz4")
+		stream        = flag.Bool("stream", false, "decode and print transactions as they come off the decompressor, instead of buffering the whole blob")
+		beaconURL     = flag.String("beacon-url", "", "L1 beacon node base URL to fetch the blob sidecar from, e.g. http://localhost:5052")
+		slot          = flag.Uint64("slot", 0, "L1 slot to fetch the blob sidecar from (requires -beacon-url and -versioned-hash)")
+		versionedHex  = flag.String("versioned-hash", "", "expected versioned blob hash (KZG commitment hash) to fetch and verify, as hex")
+		beaconTimeout = flag.Duration("beacon-timeout", blobdecoder.DefaultBeaconTimeout, "timeout for requests to -beacon-url")
+	)
+	flag.Parse()
+
+	forced, err := blobdecoder.ParseCodec(*codec)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	var data []byte
+	switch {
+	case *beaconURL != "":
+		data, err = fetchFromBeacon(*beaconURL, *slot, *versionedHex, *beaconTimeout)
+	case *file != "":
+		data, err = blobdecoder.FromHexFile(*file)
+	default:
+		data, err = blobdecoder.FromStdin()
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "读取输入失败:", err)
+		os.Exit(1)
+	}
+	fmt.Printf("input: %d bytes\n", len(data))
+
+	if *stream {
+		runStream(data, *raw, forced)
+		return
+	}
+
+	summaries, err := blobdecoder.Decode(bytes.NewReader(data), *raw, forced)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "解码失败:", err)
+		os.Exit(1)
+	}
+
+	fmt.Printf("decoded %d transactions\n", len(summaries))
+	for _, s := range summaries {
+		fmt.Printf("  [%d] hash=%s to=%s nonce=%d value=%s gas=%d\n",
+			s.Index, s.Hash, s.To, s.Nonce, s.Value, s.GasLimit)
+	}
 }
 
-func main() {
-    compressed, err := readHexFile("core/compressed_blob.hex")
-    if err != nil {
-        fmt.Println("读取文件失败:", err)
-        return
-    }
-    fmt.Printf("compressed: %d bytes\n", len(compressed))
-    fmt.Printf("compressed: %v\n", compressed)
-
-    decompressed, err := decompressData(compressed)
-    if err != nil {
-        fmt.Println("解压失败:", err)
-        return
-    }
-
-    fmt.Printf("解压数据: %d bytes\n", len(decompressed))
+// fetchFromBeacon retrieves the blob sidecar for slot from the beacon node
+// at beaconURL whose KZG commitment matches versionedHashHex, verifying
+// the commitment before returning the raw, still-padded blob bytes. The
+// request is bounded by timeout so a slow or unresponsive beacon node
+// can't hang this diagnostic tool indefinitely.
+func fetchFromBeacon(beaconURL string, slot uint64, versionedHashHex string, timeout time.Duration) ([]byte, error) {
+	if versionedHashHex == "" {
+		return nil, fmt.Errorf("-versioned-hash is required when fetching from a beacon node")
+	}
+
+	hashBytes, err := hex.DecodeString(trimHexPrefix(versionedHashHex))
+	if err != nil || len(hashBytes) != 32 {
+		return nil, fmt.Errorf("invalid -versioned-hash %q: must be 32 bytes of hex", versionedHashHex)
+	}
+	var versionedHash [32]byte
+	copy(versionedHash[:], hashBytes)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	client := blobdecoder.NewBeaconClient(beaconURL, timeout)
+	return client.FetchBlobByVersionedHash(ctx, slot, versionedHash)
+}
+
+func trimHexPrefix(s string) string {
+	if len(s) >= 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+		return s[2:]
+	}
+	return s
 }
 
-func decompressData(compressed []byte) ([]byte, error) {
-    reader := bytes.NewReader(compressed)
-    
-    zlibReader, err := zlib.NewReader(reader)
-    if err != nil {
-        return nil, fmt.Errorf("创建解压器失败: %w", err)
-    }
-    defer zlibReader.Close()
-
-    result, err := io.ReadAll(zlibReader)
-    if err != nil {
-        return nil, fmt.Errorf("读取解压数据失败: %w", err)
-    }
-
-    return result, nil
+// runStream decodes data through a StreamDecoder, printing each
+// transaction as soon as it is decoded rather than waiting for the whole
+// blob to inflate.
+func runStream(data []byte, raw bool, forced blobdecoder.Codec) {
+	dec := blobdecoder.NewStreamDecoder(raw, forced)
+	defer dec.Close()
+
+	if err := dec.Reset(bytes.NewReader(data)); err != nil {
+		fmt.Fprintln(os.Stderr, "解码失败:", err)
+		os.Exit(1)
+	}
+
+	txs, errc := dec.Transactions(16)
+	i := 0
+	for tx := range txs {
+		to := "contract creation"
+		if tx.To() != nil {
+			to = tx.To().Hex()
+		}
+		fmt.Printf("  [%d] hash=%s to=%s nonce=%d\n", i, tx.Hash().Hex(), to, tx.Nonce())
+		i++
+	}
+
+	if err := <-errc; err != nil {
+		fmt.Fprintln(os.Stderr, "解码失败:", err)
+		os.Exit(1)
+	}
+	fmt.Printf("decoded %d transactions\n", i)
 }